@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/gregorybednov/lbc_client_go/lbcclient"
+)
+
+// listRender is the format-agnostic shape a rendered entity list is reduced
+// to: Headers/Rows feed --format table|csv, Items feeds --format jsonl (one
+// compact object per line), and All feeds --format json (a single array).
+type listRender struct {
+	Headers []string
+	Rows    [][]string
+	Items   []any
+	All     any
+}
+
+func renderPromises(items []lbcclient.Promise) listRender {
+	r := listRender{Headers: []string{"id", "text", "due", "beneficiary_id", "parent_promise_id"}, All: items}
+	for _, p := range items {
+		parent := ""
+		if p.ParentPromiseID != nil {
+			parent = *p.ParentPromiseID
+		}
+		r.Rows = append(r.Rows, []string{p.ID, p.Text, strconv.FormatInt(p.Due, 10), p.BeneficiaryID, parent})
+		r.Items = append(r.Items, p)
+	}
+	return r
+}
+
+func renderCommitments(items []lbcclient.Commitment) listRender {
+	r := listRender{Headers: []string{"id", "promise_id", "commiter_id", "due"}, All: items}
+	for _, c := range items {
+		r.Rows = append(r.Rows, []string{c.ID, c.PromiseID, c.CommiterID, strconv.FormatInt(c.Due, 10)})
+		r.Items = append(r.Items, c)
+	}
+	return r
+}
+
+func renderCommiters(items []lbcclient.Commiter) listRender {
+	r := listRender{Headers: []string{"id", "name", "commiter_pubkey"}, All: items}
+	for _, c := range items {
+		r.Rows = append(r.Rows, []string{c.ID, c.Name, c.CommiterPubKey})
+		r.Items = append(r.Items, c)
+	}
+	return r
+}
+
+func renderBeneficiaries(items []lbcclient.Beneficiary) listRender {
+	r := listRender{Headers: []string{"id", "name"}, All: items}
+	for _, b := range items {
+		r.Rows = append(r.Rows, []string{b.ID, b.Name})
+		r.Items = append(r.Items, b)
+	}
+	return r
+}
+
+// printListRender writes r in the requested format: json|jsonl|table|csv.
+func printListRender(format string, r listRender) error {
+	switch format {
+	case "table":
+		w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+		fmt.Fprintln(w, joinTab(r.Headers))
+		for _, row := range r.Rows {
+			fmt.Fprintln(w, joinTab(row))
+		}
+		return w.Flush()
+	case "csv":
+		w := csv.NewWriter(os.Stdout)
+		if err := w.Write(r.Headers); err != nil {
+			return err
+		}
+		for _, row := range r.Rows {
+			if err := w.Write(row); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+	case "jsonl":
+		for _, item := range r.Items {
+			b, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+		}
+		return nil
+	case "json", "":
+		printRawJSON(r.All)
+		return nil
+	default:
+		return fmt.Errorf("unknown --format %q (want json|jsonl|table|csv)", format)
+	}
+}
+
+func joinTab(fields []string) string {
+	out := ""
+	for i, f := range fields {
+		if i > 0 {
+			out += "\t"
+		}
+		out += f
+	}
+	return out
+}
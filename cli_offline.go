@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gregorybednov/lbc_client_go/lbcclient"
+	"github.com/spf13/pflag"
+)
+
+// ===== CLI: build-tx / sign-tx / broadcast-tx (offline workflow) =====
+//
+// These split the one-shot send flow into three steps so an air-gapped
+// signer host never needs network access: build-tx emits an unsigned
+// envelope, sign-tx signs it with a local key, broadcast-tx posts the
+// signed envelope from a networked host.
+
+func readEnvelopeInput(path string) ([]byte, error) {
+	if path == "" || path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+func writeEnvelopeOutput(path string, data []byte) error {
+	data = append(data, '\n')
+	if path == "" || path == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func buildTxMain(args []string) {
+	fs := pflag.NewFlagSet("build-tx", pflag.ExitOnError)
+	var txType, out string
+	var name string
+	var text, due, beneficiaryID, parentID, commitmentDue string
+	var keystoreKind, keyID string
+
+	fs.StringVar(&txType, "type", "", "tx type: commiter|beneficiary|promise")
+	fs.StringVar(&out, "out", "-", "output file (default: stdout)")
+	fs.StringVar(&name, "name", "", "name (commiter/beneficiary)")
+	fs.StringVar(&text, "text", "", "promise text")
+	fs.StringVar(&due, "due", "", "promise due (YYYY-MM-DD or RFC3339)")
+	fs.StringVar(&beneficiaryID, "beneficiary-id", "", "beneficiary ID")
+	fs.StringVar(&parentID, "parent-id", "", "optional parent promise ID")
+	fs.StringVar(&commitmentDue, "commitment-due", "", "commitment due (YYYY-MM-DD or RFC3339)")
+	fs.StringVar(&keystoreKind, "keystore", "file", "key storage backend: file|encrypted|keyring|pkcs11")
+	fs.StringVar(&keyID, "key-id", "", "key identifier within the chosen keystore")
+	_ = fs.Parse(args)
+
+	var env *lbcclient.UnsignedEnvelope
+	var id string
+	var err error
+
+	switch txType {
+	case "commiter":
+		var ks lbcclient.KeyStore
+		ks, err = keyStoreFromFlag(keystoreKind)
+		if err == nil {
+			env, id, err = lbcclient.BuildCommiterTx(ks, keyID, name)
+		}
+	case "beneficiary":
+		env, id, err = lbcclient.BuildBeneficiaryTx(name)
+	case "promise":
+		var ks lbcclient.KeyStore
+		ks, err = keyStoreFromFlag(keystoreKind)
+		if err == nil {
+			env, id, err = lbcclient.BuildPromiseTx(ks, keyID, lbcclient.CreatePromiseArgs{
+				Text:             text,
+				DueISO:           due,
+				BeneficiaryID:    beneficiaryID,
+				ParentPromiseID:  parentID,
+				CommitmentDueISO: commitmentDue,
+			})
+		}
+	default:
+		err = fmt.Errorf("--type must be commiter|beneficiary|promise, got %q", txType)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	data, err := json.MarshalIndent(env, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := writeEnvelopeOutput(out, data); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Fprintf(os.Stderr, "✅ built unsigned %s tx (id=%s)\n", txType, id)
+}
+
+func signTxMain(args []string) {
+	fs := pflag.NewFlagSet("sign-tx", pflag.ExitOnError)
+	var in, out string
+	var keystoreKind, keyID string
+
+	fs.StringVar(&in, "in", "-", "input unsigned tx file (default: stdin)")
+	fs.StringVar(&out, "out", "-", "output signed tx file (default: stdout)")
+	fs.StringVar(&keystoreKind, "keystore", "file", "key storage backend: file|encrypted|keyring|pkcs11")
+	fs.StringVar(&keyID, "key-id", "", "key identifier within the chosen keystore")
+	_ = fs.Parse(args)
+
+	raw, err := readEnvelopeInput(in)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+	var env lbcclient.UnsignedEnvelope
+	if err := json.Unmarshal(raw, &env); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: cannot parse unsigned tx: %v\n", err)
+		os.Exit(1)
+	}
+
+	ks, err := keyStoreFromFlag(keystoreKind)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+	signed, err := lbcclient.SignEnvelope(ks, keyID, &env)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	data, err := json.MarshalIndent(signed, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := writeEnvelopeOutput(out, data); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Fprintln(os.Stderr, "✅ signed tx")
+}
+
+func broadcastTxMain(args []string) {
+	fs := pflag.NewFlagSet("broadcast-tx", pflag.ExitOnError)
+	var in, rpc, broadcastMode string
+	var retryAttempts int
+
+	fs.StringVar(&in, "in", "-", "input signed tx file (default: stdin)")
+	fs.StringVar(&rpc, "rpc", "http://localhost:26657", "Tendermint RPC URL")
+	fs.StringVar(&broadcastMode, "broadcast-mode", "commit", "broadcast_tx_* mode: sync|async|commit")
+	fs.IntVar(&retryAttempts, "retry", 1, "max broadcast attempts on transient mempool/network failures")
+	_ = fs.Parse(args)
+
+	raw, err := readEnvelopeInput(in)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+	var env lbcclient.SignedEnvelope
+	if err := json.Unmarshal(raw, &env); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: cannot parse signed tx: %v\n", err)
+		os.Exit(1)
+	}
+
+	client := lbcclient.NewClient(
+		lbcclient.WithRPCURL(rpc),
+		lbcclient.WithBroadcastMode(lbcclient.BroadcastMode(broadcastMode)),
+		lbcclient.WithRetry(lbcclient.RetryPolicy{MaxAttempts: retryAttempts, BaseDelay: 500 * time.Millisecond}),
+	)
+	if err := client.BroadcastEnvelope(context.Background(), &env); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println("✅ broadcast complete")
+}
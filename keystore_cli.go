@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gregorybednov/lbc_client_go/lbcclient"
+)
+
+// keyStoreFromFlag builds the KeyStore named by the --keystore flag. Key
+// material never passes through this function's error paths or logs.
+func keyStoreFromFlag(kind string) (lbcclient.KeyStore, error) {
+	switch kind {
+	case "", "file":
+		return lbcclient.NewFileKeyStore(), nil
+	case "encrypted":
+		passphrase := os.Getenv("LBC_KEYSTORE_PASSPHRASE")
+		if passphrase == "" {
+			return nil, fmt.Errorf("--keystore encrypted requires the LBC_KEYSTORE_PASSPHRASE environment variable")
+		}
+		return lbcclient.NewEncryptedFileKeyStore(passphrase), nil
+	case "keyring":
+		return lbcclient.NewKeyringKeyStore(), nil
+	case "pkcs11":
+		modulePath := os.Getenv("LBC_PKCS11_MODULE")
+		pin := os.Getenv("LBC_PKCS11_PIN")
+		if modulePath == "" || pin == "" {
+			return nil, fmt.Errorf("--keystore pkcs11 requires LBC_PKCS11_MODULE and LBC_PKCS11_PIN environment variables")
+		}
+		return lbcclient.NewPKCS11KeyStore(modulePath, 0, pin)
+	default:
+		return nil, fmt.Errorf("unknown --keystore %q (want file|encrypted|keyring|pkcs11)", kind)
+	}
+}
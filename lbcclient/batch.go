@@ -0,0 +1,28 @@
+package lbcclient
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+)
+
+// BroadcastBatch signs batch as a single unit and broadcasts it, retrying
+// transient mempool/network failures per the client's retry policy. The
+// canonical bytes c.sign produced are embedded as raw JSON rather than
+// re-marshaled from the struct, so key order and number formatting on the
+// wire match what was signed. encoding/json still escapes <, > and & inside
+// strings when it embeds them.
+func (c *Client) BroadcastBatch(ctx context.Context, batch AtomicBatch) error {
+	sigB64, raw, err := c.sign(batch)
+	if err != nil {
+		return err
+	}
+	txBytes, err := json.Marshal(struct {
+		Batch     json.RawMessage `json:"batch"`
+		Signature string          `json:"signature"`
+	}{Batch: raw, Signature: sigB64})
+	if err != nil {
+		return err
+	}
+	return c.broadcastWithRetry(ctx, base64.StdEncoding.EncodeToString(txBytes))
+}
@@ -0,0 +1,94 @@
+package lbcclient
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// BroadcastMode selects which Tendermint broadcast_tx_* RPC a Client uses.
+type BroadcastMode string
+
+const (
+	// BroadcastSync waits for CheckTx only (broadcast_tx_sync).
+	BroadcastSync BroadcastMode = "sync"
+	// BroadcastAsync returns immediately, without waiting for CheckTx
+	// (broadcast_tx_async).
+	BroadcastAsync BroadcastMode = "async"
+	// BroadcastCommit waits for the tx to be included in a block
+	// (broadcast_tx_commit). This is the client's original, and default,
+	// behavior.
+	BroadcastCommit BroadcastMode = "commit"
+)
+
+func (m BroadcastMode) rpcMethod() string {
+	switch m {
+	case BroadcastSync:
+		return "broadcast_tx_sync"
+	case BroadcastAsync:
+		return "broadcast_tx_async"
+	default:
+		return "broadcast_tx_commit"
+	}
+}
+
+// WithBroadcastMode selects sync/async/commit broadcast semantics (default:
+// BroadcastCommit).
+func WithBroadcastMode(mode BroadcastMode) Option {
+	return func(c *Client) { c.broadcastMode = mode }
+}
+
+// isTransientBroadcastError reports whether err is worth retrying: a
+// mempool-level rejection that's expected to clear up on its own (tx
+// already seen, broadcast timeout) or a 5xx from the RPC endpoint.
+// Permanent failures — bad signatures, DeliverTx logic errors, 4xx — are
+// not retried.
+func isTransientBroadcastError(err error) bool {
+	if errors.Is(err, errTxInCache) {
+		return true
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var httpErr *httpStatusError
+	if errors.As(err, &httpErr) {
+		return httpErr.status >= 500
+	}
+	return false
+}
+
+// broadcastWithRetry calls postRPC, retrying transient mempool/network
+// failures with exponential backoff and jitter per c.retry. Permanent
+// failures are returned immediately.
+func (c *Client) broadcastWithRetry(ctx context.Context, txB64 string) error {
+	attempts := c.retry.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := c.retry.BaseDelay
+	if delay <= 0 {
+		delay = 500 * time.Millisecond
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		err := c.postRPC(ctx, txB64)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !isTransientBroadcastError(err) || attempt == attempts-1 {
+			return lastErr
+		}
+		c.logger.Printf("broadcast attempt %d/%d failed transiently: %v (retrying in %s)", attempt+1, attempts, err, delay)
+		wait := delay + time.Duration(rand.Int63n(int64(delay)/2+1))
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		delay *= 2
+	}
+	return lastErr
+}
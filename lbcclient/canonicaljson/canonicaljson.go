@@ -0,0 +1,225 @@
+// Package canonicaljson implements RFC 8785 JSON Canonicalization Scheme
+// (JCS): object keys sorted lexicographically by UTF-16 code unit, numbers
+// normalized per ECMA-262 Number::toString, strings escaped with the
+// minimal RFC 8259 §7 escape set, UTF-8 output with no insignificant
+// whitespace.
+//
+// The server re-marshals a signed tx body to verify its signature; without
+// a canonical form, two semantically-identical bodies can serialize
+// differently (key order, number formatting) and silently fail CheckTx.
+// CanonicalMarshal and SignCanonical pin that serialization down.
+package canonicaljson
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+)
+
+// CanonicalMarshal serializes v as JCS canonical JSON.
+func CanonicalMarshal(v any) ([]byte, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("canonicaljson: marshal: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var parsed any
+	if err := dec.Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("canonicaljson: decode: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := encodeValue(&buf, parsed); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// SignCanonical canonically marshals v and ed25519-signs the result,
+// returning the base64 signature alongside the signed bytes.
+func SignCanonical(priv ed25519.PrivateKey, v any) (sigB64 string, raw []byte, err error) {
+	raw, err = CanonicalMarshal(v)
+	if err != nil {
+		return "", nil, err
+	}
+	sig := ed25519.Sign(priv, raw)
+	return base64.StdEncoding.EncodeToString(sig), raw, nil
+}
+
+func encodeValue(buf *bytes.Buffer, v any) error {
+	switch t := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(t))
+	case json.Number:
+		s, err := formatNumber(t)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(s)
+	case string:
+		encodeString(buf, t)
+	case map[string]any:
+		return encodeObject(buf, t)
+	case []any:
+		return encodeArray(buf, t)
+	default:
+		return fmt.Errorf("canonicaljson: unsupported value of type %T", v)
+	}
+	return nil
+}
+
+func encodeObject(buf *bytes.Buffer, m map[string]any) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return lessUTF16(keys[i], keys[j]) })
+
+	buf.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		encodeString(buf, k)
+		buf.WriteByte(':')
+		if err := encodeValue(buf, m[k]); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func encodeArray(buf *bytes.Buffer, arr []any) error {
+	buf.WriteByte('[')
+	for i, v := range arr {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := encodeValue(buf, v); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+// lessUTF16 orders a and b lexicographically by UTF-16 code unit, as JCS
+// requires (not by UTF-8 byte, which differs for characters outside the
+// basic multilingual plane).
+func lessUTF16(a, b string) bool {
+	au, bu := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+	for i := 0; i < len(au) && i < len(bu); i++ {
+		if au[i] != bu[i] {
+			return au[i] < bu[i]
+		}
+	}
+	return len(au) < len(bu)
+}
+
+// encodeString writes s as a JSON string literal using the minimal RFC 8259
+// §7 escape set: '"', '\\', and control characters. Everything else,
+// including non-ASCII runes, is emitted as literal UTF-8.
+func encodeString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// formatNumber renders n per ECMA-262 Number::toString (7.1.12.1), the
+// normalization JCS mandates for JSON numbers.
+func formatNumber(n json.Number) (string, error) {
+	f, err := n.Float64()
+	if err != nil {
+		return "", fmt.Errorf("canonicaljson: number %q does not fit float64: %w", n, err)
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("canonicaljson: %v is not representable in JSON", f)
+	}
+	return ecmaNumberToString(f), nil
+}
+
+func ecmaNumberToString(f float64) string {
+	if f == 0 {
+		return "0" // covers -0 too: ECMA-262 prints "0" for negative zero
+	}
+	sign := ""
+	if f < 0 {
+		sign = "-"
+		f = -f
+	}
+
+	// Shortest round-tripping decimal digits, in d.ddddde±dd form.
+	mant := strconv.AppendFloat(nil, f, 'e', -1, 64)
+	dot := bytes.IndexByte(mant, '.')
+	eIdx := bytes.IndexByte(mant, 'e')
+
+	var digits string
+	if dot == -1 {
+		digits = string(mant[:eIdx])
+	} else {
+		digits = string(mant[:dot]) + string(mant[dot+1:eIdx])
+	}
+	exp, _ := strconv.Atoi(string(mant[eIdx+1:]))
+	k := len(digits)
+	n := exp + 1
+
+	switch {
+	case k <= n && n <= 21:
+		return sign + digits + zeros(n-k)
+	case 0 < n && n <= 21:
+		return sign + digits[:n] + "." + digits[n:]
+	case -6 < n && n <= 0:
+		return sign + "0." + zeros(-n) + digits
+	default:
+		e := n - 1
+		expSign := "+"
+		if e < 0 {
+			expSign = "-"
+			e = -e
+		}
+		if k == 1 {
+			return fmt.Sprintf("%s%se%s%d", sign, digits, expSign, e)
+		}
+		return fmt.Sprintf("%s%s.%se%s%d", sign, digits[:1], digits[1:], expSign, e)
+	}
+}
+
+func zeros(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return string(bytes.Repeat([]byte{'0'}, n))
+}
@@ -0,0 +1,67 @@
+package canonicaljson
+
+import "testing"
+
+// Vectors drawn from the RFC 8785 JCS examples plus this package's own
+// number/string edge cases.
+func TestCanonicalMarshal(t *testing.T) {
+	cases := []struct {
+		name string
+		in   any
+		want string
+	}{
+		{"object keys sorted", map[string]any{"b": 1, "a": 2}, `{"a":2,"b":1}`},
+		{
+			"nested object keys sorted",
+			map[string]any{"outer": map[string]any{"z": 1, "m": 2, "a": 3}},
+			`{"outer":{"a":3,"m":2,"z":1}}`,
+		},
+		{"array preserves order", []any{3, 1, 2}, `[3,1,2]`},
+		{"integer", 123, `123`},
+		{"negative integer", -42, `-42`},
+		{"zero", 0, `0`},
+		{"negative zero", -0.0, `0`},
+		{"simple fraction", 1.5, `1.5`},
+		{"small fraction", 0.0001, `0.0001`},
+		{"very small goes exponential", 0.0000001, `1e-7`},
+		{"large integer stays plain", 1e20, `100000000000000000000`},
+		{"very large goes exponential", 1e21, `1e+21`},
+		{"string with quote and backslash", `a"b\c`, `"a\"b\\c"`},
+		{"string with control char", "a\tb", `"a\tb"`},
+		{"string with newline", "a\nb", `"a\nb"`},
+		{"string leaves forward slash alone", "a/b", `"a/b"`},
+		{"string leaves non-ascii alone", "héllo", `"héllo"`},
+		{"null", nil, `null`},
+		{"bool true", true, `true`},
+		{"bool false", false, `false`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CanonicalMarshal(tc.in)
+			if err != nil {
+				t.Fatalf("CanonicalMarshal(%#v): %v", tc.in, err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("CanonicalMarshal(%#v) = %s, want %s", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalMarshalDeterministic(t *testing.T) {
+	a := map[string]any{"z": 1, "a": 2, "m": []any{1, 2, 3}}
+	b := map[string]any{"a": 2, "m": []any{1, 2, 3}, "z": 1}
+
+	got1, err := CanonicalMarshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got2, err := CanonicalMarshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got1) != string(got2) {
+		t.Errorf("expected identical canonical output regardless of map literal order: %s != %s", got1, got2)
+	}
+}
@@ -0,0 +1,109 @@
+package lbcclient
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gregorybednov/lbc_client_go/lbcclient/canonicaljson"
+)
+
+// Client is the entry point for talking to an lbc node. Construct one with
+// NewClient and the With* options below.
+type Client struct {
+	httpClient    *http.Client
+	rpcURL        string
+	keyStore      KeyStore
+	keyID         string
+	logger        *log.Logger
+	retry         RetryPolicy
+	broadcastMode BroadcastMode
+	baseCtx       context.Context
+}
+
+// RetryPolicy controls how Client retries transient RPC failures.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+// Option configures a Client. See WithHTTPClient, WithRPCURL, WithKeyStore,
+// WithLogger, WithRetry and WithContext.
+type Option func(*Client)
+
+// WithHTTPClient overrides the http.Client used for RPC requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithRPCURL sets the Tendermint RPC endpoint (default http://localhost:26657).
+func WithRPCURL(url string) Option {
+	return func(c *Client) { c.rpcURL = url }
+}
+
+// WithKeyStore overrides where the signing key pair is loaded from (default:
+// plaintext files under ./config).
+func WithKeyStore(ks KeyStore) Option {
+	return func(c *Client) { c.keyStore = ks }
+}
+
+// WithKeyID selects which key the configured KeyStore should use (default:
+// "", the store's own default key).
+func WithKeyID(keyID string) Option {
+	return func(c *Client) { c.keyID = keyID }
+}
+
+// WithLogger sets the logger used for diagnostic output (default: discard).
+func WithLogger(l *log.Logger) Option {
+	return func(c *Client) { c.logger = l }
+}
+
+// WithRetry sets the retry policy applied to transient RPC failures.
+func WithRetry(r RetryPolicy) Option {
+	return func(c *Client) { c.retry = r }
+}
+
+// WithContext stores a base context on the Client. Every Client method
+// (including Watch and its reconnect loop) takes its own context.Context
+// argument; none of them reads this stored context.
+func WithContext(ctx context.Context) Option {
+	return func(c *Client) { c.baseCtx = ctx }
+}
+
+// NewClient builds a Client with sane defaults, applying opts in order.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		httpClient:    http.DefaultClient,
+		rpcURL:        "http://localhost:26657",
+		keyStore:      fileKeyStore{},
+		logger:        log.New(io.Discard, "", 0),
+		retry:         RetryPolicy{MaxAttempts: 1},
+		broadcastMode: BroadcastCommit,
+		baseCtx:       context.Background(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Client) publicKey() (ed25519.PublicKey, error) { return c.keyStore.PublicKey(c.keyID) }
+
+// sign canonically marshals body (so the server re-marshaling it for
+// CheckTx sees exactly the bytes we signed) and signs the result through the
+// configured KeyStore.
+func (c *Client) sign(body any) (sigB64 string, raw []byte, err error) {
+	raw, err = canonicaljson.CanonicalMarshal(body)
+	if err != nil {
+		return "", nil, err
+	}
+	sig, err := c.keyStore.Sign(c.keyID, raw)
+	if err != nil {
+		return "", nil, err
+	}
+	return base64.StdEncoding.EncodeToString(sig), raw, nil
+}
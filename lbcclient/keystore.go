@@ -0,0 +1,105 @@
+package lbcclient
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Keypair holds an ed25519 key pair, as passed to KeyStore.Save. No KeyStore
+// method hands private material back: the keyring backend stores only
+// Private and derives the public key from it, and the pkcs11 backend does
+// not accept a Keypair at all.
+type Keypair struct {
+	Public  ed25519.PublicKey
+	Private ed25519.PrivateKey
+}
+
+// KeyStore abstracts where the signing key lives and how signing happens.
+// Implementations are free to keep the private key out of process memory
+// entirely (see the pkcs11 backend) — Sign is the only operation that
+// touches it.
+type KeyStore interface {
+	// Load ensures keyID exists, generating a fresh key pair if this is the
+	// first use.
+	Load(keyID string) error
+	// Save persists kp under keyID.
+	Save(keyID string, kp Keypair) error
+	// PublicKey returns the public half of keyID.
+	PublicKey(keyID string) (ed25519.PublicKey, error)
+	// Sign signs message with the private key under keyID.
+	Sign(keyID string, message []byte) ([]byte, error)
+}
+
+const (
+	defaultConfigDir   = "./config"
+	defaultPrivKeyPath = defaultConfigDir + "/ed25519.key"
+	defaultPubKeyPath  = defaultConfigDir + "/ed25519.pub"
+)
+
+// fileKeyStore stores key pairs as plaintext files on disk, generating a new
+// one on first use. This is the client's original behavior and remains the
+// default.
+type fileKeyStore struct{}
+
+// NewFileKeyStore returns the default plaintext-file KeyStore, for callers
+// (like the offline build-tx/sign-tx commands) that need an explicit
+// instance rather than relying on Client's built-in default.
+func NewFileKeyStore() KeyStore { return fileKeyStore{} }
+
+func (fileKeyStore) paths(keyID string) (privPath, pubPath string) {
+	if keyID == "" {
+		return defaultPrivKeyPath, defaultPubKeyPath
+	}
+	return filepath.Join(defaultConfigDir, keyID+".key"), filepath.Join(defaultConfigDir, keyID+".pub")
+}
+
+func (s fileKeyStore) Load(keyID string) error {
+	privPath, _ := s.paths(keyID)
+	if _, err := os.Stat(privPath); !os.IsNotExist(err) {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, "🔐 Generating new ed25519 keypair...")
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	return s.Save(keyID, Keypair{Public: pub, Private: priv})
+}
+
+func (s fileKeyStore) Save(keyID string, kp Keypair) error {
+	privPath, pubPath := s.paths(keyID)
+	if err := os.MkdirAll(defaultConfigDir, 0700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(privPath, kp.Private, 0600); err != nil {
+		return err
+	}
+	return os.WriteFile(pubPath, kp.Public, 0644)
+}
+
+func (s fileKeyStore) PublicKey(keyID string) (ed25519.PublicKey, error) {
+	if err := s.Load(keyID); err != nil {
+		return nil, err
+	}
+	_, pubPath := s.paths(keyID)
+	pub, err := os.ReadFile(pubPath)
+	if err != nil {
+		return nil, err
+	}
+	return ed25519.PublicKey(pub), nil
+}
+
+func (s fileKeyStore) Sign(keyID string, message []byte) ([]byte, error) {
+	if err := s.Load(keyID); err != nil {
+		return nil, err
+	}
+	privPath, _ := s.paths(keyID)
+	priv, err := os.ReadFile(privPath)
+	if err != nil {
+		return nil, err
+	}
+	return ed25519.Sign(ed25519.PrivateKey(priv), message), nil
+}
@@ -0,0 +1,146 @@
+package lbcclient
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	scryptSaltLen = 16
+	scryptN       = 1 << 15
+	scryptR       = 8
+	scryptP       = 1
+	secretboxKey  = 32
+)
+
+// encryptedFileKeyStore stores the private key passphrase-encrypted at rest
+// (scrypt for key derivation, NaCl secretbox for authenticated encryption).
+// The passphrase is held only in memory for the lifetime of the process and
+// is never logged or written to disk.
+type encryptedFileKeyStore struct {
+	passphrase []byte
+}
+
+// NewEncryptedFileKeyStore returns a KeyStore that encrypts the private key
+// at rest with passphrase. The public key is still stored in the clear,
+// matching the plaintext file store's layout.
+func NewEncryptedFileKeyStore(passphrase string) KeyStore {
+	return &encryptedFileKeyStore{passphrase: []byte(passphrase)}
+}
+
+func (s *encryptedFileKeyStore) paths(keyID string) (privPath, pubPath string) {
+	if keyID == "" {
+		keyID = "ed25519"
+	}
+	return filepath.Join(defaultConfigDir, keyID+".key.enc"), filepath.Join(defaultConfigDir, keyID+".pub")
+}
+
+func (s *encryptedFileKeyStore) deriveKey(salt []byte) (*[secretboxKey]byte, error) {
+	raw, err := scrypt.Key(s.passphrase, salt, scryptN, scryptR, scryptP, secretboxKey)
+	if err != nil {
+		return nil, fmt.Errorf("derive key: %w", err)
+	}
+	var key [secretboxKey]byte
+	copy(key[:], raw)
+	return &key, nil
+}
+
+func (s *encryptedFileKeyStore) Load(keyID string) error {
+	privPath, _ := s.paths(keyID)
+	if _, err := os.Stat(privPath); !os.IsNotExist(err) {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, "🔐 Generating new ed25519 keypair (encrypted at rest)...")
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	return s.Save(keyID, Keypair{Public: pub, Private: priv})
+}
+
+func (s *encryptedFileKeyStore) Save(keyID string, kp Keypair) error {
+	privPath, pubPath := s.paths(keyID)
+	if err := os.MkdirAll(defaultConfigDir, 0700); err != nil {
+		return err
+	}
+
+	var salt [scryptSaltLen]byte
+	if _, err := rand.Read(salt[:]); err != nil {
+		return err
+	}
+	key, err := s.deriveKey(salt[:])
+	if err != nil {
+		return err
+	}
+	var nonce [24]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return err
+	}
+	sealed := secretbox.Seal(nonce[:], kp.Private, &nonce, key)
+	payload := append(salt[:], sealed...)
+
+	if err := os.WriteFile(privPath, []byte(base64.StdEncoding.EncodeToString(payload)), 0600); err != nil {
+		return err
+	}
+	return os.WriteFile(pubPath, kp.Public, 0644)
+}
+
+func (s *encryptedFileKeyStore) loadPrivate(keyID string) (ed25519.PrivateKey, error) {
+	if err := s.Load(keyID); err != nil {
+		return nil, err
+	}
+	privPath, _ := s.paths(keyID)
+	encoded, err := os.ReadFile(privPath)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := base64.StdEncoding.DecodeString(string(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("decode encrypted key: %w", err)
+	}
+	if len(payload) < scryptSaltLen+24 {
+		return nil, errors.New("encrypted key file is truncated")
+	}
+	salt, rest := payload[:scryptSaltLen], payload[scryptSaltLen:]
+	var nonce [24]byte
+	copy(nonce[:], rest[:24])
+	sealed := rest[24:]
+
+	key, err := s.deriveKey(salt)
+	if err != nil {
+		return nil, err
+	}
+	priv, ok := secretbox.Open(nil, sealed, &nonce, key)
+	if !ok {
+		return nil, errors.New("wrong passphrase or corrupted key file")
+	}
+	return ed25519.PrivateKey(priv), nil
+}
+
+func (s *encryptedFileKeyStore) PublicKey(keyID string) (ed25519.PublicKey, error) {
+	if err := s.Load(keyID); err != nil {
+		return nil, err
+	}
+	_, pubPath := s.paths(keyID)
+	pub, err := os.ReadFile(pubPath)
+	if err != nil {
+		return nil, err
+	}
+	return ed25519.PublicKey(pub), nil
+}
+
+func (s *encryptedFileKeyStore) Sign(keyID string, message []byte) ([]byte, error) {
+	priv, err := s.loadPrivate(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return ed25519.Sign(priv, message), nil
+}
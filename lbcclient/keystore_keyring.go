@@ -0,0 +1,86 @@
+package lbcclient
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/zalando/go-keyring"
+)
+
+const keyringService = "lbc_client_go"
+
+// keyringKeyStore stores the private key in the OS credential store (macOS
+// Keychain, Windows Credential Manager, or libsecret on Linux) via
+// go-keyring. The public key is derived from the private key on demand, so
+// nothing but the encrypted OS vault ever touches disk.
+type keyringKeyStore struct{}
+
+// NewKeyringKeyStore returns a KeyStore backed by the OS keychain.
+func NewKeyringKeyStore() KeyStore {
+	return keyringKeyStore{}
+}
+
+func (keyringKeyStore) account(keyID string) string {
+	if keyID == "" {
+		return "default"
+	}
+	return keyID
+}
+
+func (s keyringKeyStore) Load(keyID string) error {
+	_, err := keyring.Get(keyringService, s.account(keyID))
+	if err == nil {
+		return nil
+	}
+	if err != keyring.ErrNotFound {
+		return fmt.Errorf("read keyring: %w", err)
+	}
+	fmt.Fprintln(os.Stderr, "🔐 Generating new ed25519 keypair (OS keychain)...")
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	return s.Save(keyID, Keypair{Private: priv})
+}
+
+func (s keyringKeyStore) Save(keyID string, kp Keypair) error {
+	encoded := base64.StdEncoding.EncodeToString(kp.Private)
+	if err := keyring.Set(keyringService, s.account(keyID), encoded); err != nil {
+		return fmt.Errorf("write keyring: %w", err)
+	}
+	return nil
+}
+
+func (s keyringKeyStore) loadPrivate(keyID string) (ed25519.PrivateKey, error) {
+	if err := s.Load(keyID); err != nil {
+		return nil, err
+	}
+	encoded, err := keyring.Get(keyringService, s.account(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("read keyring: %w", err)
+	}
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode keyring secret: %w", err)
+	}
+	return ed25519.PrivateKey(raw), nil
+}
+
+func (s keyringKeyStore) PublicKey(keyID string) (ed25519.PublicKey, error) {
+	priv, err := s.loadPrivate(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return priv.Public().(ed25519.PublicKey), nil
+}
+
+func (s keyringKeyStore) Sign(keyID string, message []byte) ([]byte, error) {
+	priv, err := s.loadPrivate(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return ed25519.Sign(priv, message), nil
+}
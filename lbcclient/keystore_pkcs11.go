@@ -0,0 +1,112 @@
+//go:build pkcs11
+
+package lbcclient
+
+import (
+	"crypto/ed25519"
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/miekg/pkcs11"
+)
+
+// pkcs11KeyStore signs through a PKCS#11 token (an HSM or smartcard). The
+// private key never leaves the module — Sign submits the message to the
+// token (CKM_EDDSA) and returns whatever signature it hands back.
+type pkcs11KeyStore struct {
+	ctx    *pkcs11.Ctx
+	slot   uint
+	pin    string
+	handle pkcs11.SessionHandle
+}
+
+// NewPKCS11KeyStore opens modulePath (the vendor's PKCS#11 shared library)
+// and logs into slot with pin. keyID, in this backend, names a token label
+// rather than a local file.
+func NewPKCS11KeyStore(modulePath string, slot uint, pin string) (KeyStore, error) {
+	ctx := pkcs11.New(modulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("load pkcs11 module %q", modulePath)
+	}
+	if err := ctx.Initialize(); err != nil {
+		return nil, fmt.Errorf("initialize pkcs11 module: %w", err)
+	}
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("open pkcs11 session: %w", err)
+	}
+	if err := ctx.Login(session, pkcs11.CKU_USER, pin); err != nil {
+		return nil, fmt.Errorf("pkcs11 login: %w", err)
+	}
+	return &pkcs11KeyStore{ctx: ctx, slot: slot, pin: pin, handle: session}, nil
+}
+
+func (s *pkcs11KeyStore) findKey(keyID string, class uint) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, keyID),
+	}
+	if err := s.ctx.FindObjectsInit(s.handle, template); err != nil {
+		return 0, err
+	}
+	defer s.ctx.FindObjectsFinal(s.handle)
+	handles, _, err := s.ctx.FindObjects(s.handle, 1)
+	if err != nil {
+		return 0, err
+	}
+	if len(handles) == 0 {
+		return 0, fmt.Errorf("no pkcs11 object labelled %q", keyID)
+	}
+	return handles[0], nil
+}
+
+// Load never generates a key: key provisioning on an HSM is an out-of-band
+// administrative action. It only checks that a private key labelled keyID
+// exists on the token, returning an error if it does not.
+func (s *pkcs11KeyStore) Load(keyID string) error {
+	_, err := s.findKey(keyID, pkcs11.CKO_PRIVATE_KEY)
+	return err
+}
+
+// Save is unsupported: the private key is generated and held on the token.
+func (s *pkcs11KeyStore) Save(keyID string, kp Keypair) error {
+	return fmt.Errorf("pkcs11 keystore does not support importing keys; provision %q on the token directly", keyID)
+}
+
+func (s *pkcs11KeyStore) PublicKey(keyID string) (ed25519.PublicKey, error) {
+	handle, err := s.findKey(keyID, pkcs11.CKO_PUBLIC_KEY)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := s.ctx.GetAttributeValue(s.handle, handle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read pkcs11 public key: %w", err)
+	}
+	// CKA_EC_POINT for an Ed25519 key is a DER OCTET STRING wrapping the
+	// raw 32-byte point, not the point itself; unwrap it.
+	var point []byte
+	if _, err := asn1.Unmarshal(attrs[0].Value, &point); err != nil {
+		return nil, fmt.Errorf("decode pkcs11 CKA_EC_POINT: %w", err)
+	}
+	if len(point) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("pkcs11 public key has unexpected length %d", len(point))
+	}
+	return ed25519.PublicKey(point), nil
+}
+
+func (s *pkcs11KeyStore) Sign(keyID string, message []byte) ([]byte, error) {
+	handle, err := s.findKey(keyID, pkcs11.CKO_PRIVATE_KEY)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ctx.SignInit(s.handle, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_EDDSA, nil)}, handle); err != nil {
+		return nil, fmt.Errorf("pkcs11 sign init: %w", err)
+	}
+	sig, err := s.ctx.Sign(s.handle, message)
+	if err != nil {
+		return nil, fmt.Errorf("pkcs11 sign: %w", err)
+	}
+	return sig, nil
+}
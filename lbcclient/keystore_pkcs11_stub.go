@@ -0,0 +1,11 @@
+//go:build !pkcs11
+
+package lbcclient
+
+import "fmt"
+
+// NewPKCS11KeyStore requires building with -tags pkcs11 (it links against
+// the vendor's PKCS#11 shared library via cgo).
+func NewPKCS11KeyStore(modulePath string, slot uint, pin string) (KeyStore, error) {
+	return nil, fmt.Errorf("pkcs11 keystore support was not built in; rebuild with -tags pkcs11")
+}
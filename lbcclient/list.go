@@ -0,0 +1,98 @@
+package lbcclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gregorybednov/lbc_client_go/lbcclient/querydsl"
+)
+
+// Cursor opaquely identifies where a paginated list query left off; pass it
+// back as ListOptions.Cursor to fetch the next page. The empty Cursor means
+// there is no further page.
+type Cursor string
+
+// ListOptions narrows and paginates a List* call. The zero value applies no
+// filter and sends no limit, leaving the page size to the server's default.
+type ListOptions struct {
+	BeneficiaryID  string
+	CommiterPubKey string
+	DueAfter       int64
+	DueBefore      int64
+	Limit          int
+	Cursor         Cursor
+}
+
+// listPage is the pagination envelope the ABCI app returns for a filtered or
+// paginated /list/<entity> query. An unfiltered, unpaginated query may still
+// return a bare JSON array for backward compatibility, which listEntity
+// falls back to decoding directly.
+type listPage struct {
+	Items      json.RawMessage `json:"items"`
+	NextCursor string          `json:"next_cursor"`
+}
+
+// listEntity queries entity with opts encoded via querydsl and decodes the
+// result into []T, returning the cursor for the next page (empty if there
+// isn't one). It backs the typed List* methods below.
+func listEntity[T any](ctx context.Context, c *Client, entity string, opts ListOptions) ([]T, Cursor, error) {
+	path, err := entityAlias(entity)
+	if err != nil {
+		return nil, "", err
+	}
+	filterBytes, err := querydsl.Encode(querydsl.Filter{
+		BeneficiaryID:  opts.BeneficiaryID,
+		CommiterPubKey: opts.CommiterPubKey,
+		DueAfter:       opts.DueAfter,
+		DueBefore:      opts.DueBefore,
+		Limit:          opts.Limit,
+		Cursor:         string(opts.Cursor),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("encode query filter: %w", err)
+	}
+
+	res, err := c.Query(ctx, path, string(filterBytes), "")
+	if err != nil {
+		return nil, "", err
+	}
+	if len(res.Value) == 0 {
+		return nil, "", nil
+	}
+
+	var page listPage
+	if err := json.Unmarshal(res.Value, &page); err != nil || page.Items == nil {
+		var items []T
+		if err := json.Unmarshal(res.Value, &items); err != nil {
+			return nil, "", fmt.Errorf("decode %s list: %w", entity, err)
+		}
+		return items, "", nil
+	}
+
+	var items []T
+	if err := json.Unmarshal(page.Items, &items); err != nil {
+		return nil, "", fmt.Errorf("decode %s list: %w", entity, err)
+	}
+	return items, Cursor(page.NextCursor), nil
+}
+
+// ListPromises fetches a page of promises matching opts.
+func (c *Client) ListPromises(ctx context.Context, opts ListOptions) ([]Promise, Cursor, error) {
+	return listEntity[Promise](ctx, c, "promise", opts)
+}
+
+// ListCommitments fetches a page of commitments matching opts.
+func (c *Client) ListCommitments(ctx context.Context, opts ListOptions) ([]Commitment, Cursor, error) {
+	return listEntity[Commitment](ctx, c, "commitment", opts)
+}
+
+// ListCommiters fetches a page of commiters matching opts.
+func (c *Client) ListCommiters(ctx context.Context, opts ListOptions) ([]Commiter, Cursor, error) {
+	return listEntity[Commiter](ctx, c, "commiter", opts)
+}
+
+// ListBeneficiaries fetches a page of beneficiaries matching opts.
+func (c *Client) ListBeneficiaries(ctx context.Context, opts ListOptions) ([]Beneficiary, Cursor, error) {
+	return listEntity[Beneficiary](ctx, c, "beneficiary", opts)
+}
@@ -0,0 +1,146 @@
+package lbcclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gregorybednov/lbc_client_go/lbcclient/canonicaljson"
+)
+
+// EnvelopeVersion is the current on-disk tx envelope format version.
+// Envelopes carry it as "v" so future format changes can be detected.
+const EnvelopeVersion = 1
+
+// TxKind discriminates what an envelope's Body decodes to.
+type TxKind string
+
+const (
+	KindCommiter    TxKind = "commiter"
+	KindBeneficiary TxKind = "beneficiary"
+	KindBatch       TxKind = "batch"
+)
+
+// UnsignedEnvelope is what build-tx emits: a canonically-serialized body
+// with no signature yet, ready to be carried to a signer host.
+type UnsignedEnvelope struct {
+	V    int             `json:"v"`
+	Kind TxKind          `json:"kind"`
+	Body json.RawMessage `json:"body"`
+}
+
+// SignedEnvelope is what sign-tx emits: the same Body bytes, untouched, plus
+// the signature and signing public key. broadcast-tx consumes this.
+type SignedEnvelope struct {
+	V         int             `json:"v"`
+	Kind      TxKind          `json:"kind"`
+	Body      json.RawMessage `json:"body"`
+	Signature string          `json:"signature"`
+	PubKey    string          `json:"pubkey"`
+}
+
+// BuildCommiterTx canonically serializes a commiter-registration body into
+// an unsigned envelope, without touching the network.
+func BuildCommiterTx(ks KeyStore, keyID, name string) (*UnsignedEnvelope, string, error) {
+	pub, err := ks.PublicKey(keyID)
+	if err != nil {
+		return nil, "", err
+	}
+	body, id := commiterBody(pub, name)
+	raw, err := canonicaljson.CanonicalMarshal(body)
+	if err != nil {
+		return nil, "", err
+	}
+	return &UnsignedEnvelope{V: EnvelopeVersion, Kind: KindCommiter, Body: raw}, id, nil
+}
+
+// BuildBeneficiaryTx canonically serializes a new-beneficiary body into an
+// unsigned envelope.
+func BuildBeneficiaryTx(name string) (*UnsignedEnvelope, string, error) {
+	body, id := beneficiaryBody(name)
+	raw, err := canonicaljson.CanonicalMarshal(body)
+	if err != nil {
+		return nil, "", err
+	}
+	return &UnsignedEnvelope{V: EnvelopeVersion, Kind: KindBeneficiary, Body: raw}, id, nil
+}
+
+// BuildPromiseTx canonically serializes a promise+commitment batch into an
+// unsigned envelope.
+func BuildPromiseTx(ks KeyStore, keyID string, args CreatePromiseArgs) (*UnsignedEnvelope, string, error) {
+	pub, err := ks.PublicKey(keyID)
+	if err != nil {
+		return nil, "", err
+	}
+	batch, id, err := promiseAndCommitmentBatch(pub, args)
+	if err != nil {
+		return nil, "", err
+	}
+	raw, err := canonicaljson.CanonicalMarshal(batch)
+	if err != nil {
+		return nil, "", err
+	}
+	return &UnsignedEnvelope{V: EnvelopeVersion, Kind: KindBatch, Body: raw}, id, nil
+}
+
+// SignEnvelope signs env.Body exactly as built (the signer host never
+// reconstructs or re-marshals it; it only strips the insignificant
+// whitespace an indented envelope file adds) and returns the signed envelope.
+func SignEnvelope(ks KeyStore, keyID string, env *UnsignedEnvelope) (*SignedEnvelope, error) {
+	if env.V != EnvelopeVersion {
+		return nil, fmt.Errorf("unsupported tx envelope version %d (want %d)", env.V, EnvelopeVersion)
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, env.Body); err != nil {
+		return nil, fmt.Errorf("compact tx body: %w", err)
+	}
+	body := json.RawMessage(compact.Bytes())
+	sig, err := ks.Sign(keyID, body)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := ks.PublicKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedEnvelope{
+		V:         env.V,
+		Kind:      env.Kind,
+		Body:      body,
+		Signature: base64.StdEncoding.EncodeToString(sig),
+		PubKey:    base64.StdEncoding.EncodeToString(pub),
+	}, nil
+}
+
+// BroadcastEnvelope rebuilds the wire tx from a signed envelope (embedding
+// env.Body as raw JSON rather than decoding and re-marshaling it) and
+// broadcasts it. encoding/json compacts the embedded body, which drops the
+// indentation sign-tx writes, and escapes <, > and & inside its strings.
+func (c *Client) BroadcastEnvelope(ctx context.Context, env *SignedEnvelope) error {
+	if env.V != EnvelopeVersion {
+		return fmt.Errorf("unsupported tx envelope version %d (want %d)", env.V, EnvelopeVersion)
+	}
+
+	var txBytes []byte
+	var err error
+	switch env.Kind {
+	case KindBatch:
+		txBytes, err = json.Marshal(struct {
+			Batch     json.RawMessage `json:"batch"`
+			Signature string          `json:"signature"`
+		}{Batch: env.Body, Signature: env.Signature})
+	case KindCommiter, KindBeneficiary:
+		txBytes, err = json.Marshal(struct {
+			Body      json.RawMessage `json:"body"`
+			Signature string          `json:"signature"`
+		}{Body: env.Body, Signature: env.Signature})
+	default:
+		return fmt.Errorf("unknown tx kind %q", env.Kind)
+	}
+	if err != nil {
+		return err
+	}
+	return c.broadcastWithRetry(ctx, base64.StdEncoding.EncodeToString(txBytes))
+}
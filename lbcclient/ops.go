@@ -0,0 +1,207 @@
+package lbcclient
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func mustUUID(prefix string) string { return prefix + ":" + uuid.NewString() }
+
+func parseWhen(s string) (int64, error) {
+	if s == "" {
+		return 0, errors.New("missing datetime")
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Unix(), nil
+	}
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix(), nil
+	}
+	return 0, fmt.Errorf("cannot parse time: %q (use 2006-01-02 or RFC3339)", s)
+}
+
+// commiterBody builds a commiter-registration body for pub, without
+// touching the network or a KeyStore — used by both the online
+// RegisterCommiter path and the offline build-tx path.
+func commiterBody(pub ed25519.PublicKey, name string) (Commiter, string) {
+	pubB64 := base64.StdEncoding.EncodeToString(pub)
+	id := "commiter:" + pubB64
+	return Commiter{
+		Type:           "commiter",
+		ID:             id,
+		Name:           name,
+		CommiterPubKey: pubB64,
+	}, id
+}
+
+// beneficiaryBody builds a new beneficiary body with a fresh ID.
+func beneficiaryBody(name string) (Beneficiary, string) {
+	id := mustUUID("beneficiary")
+	return Beneficiary{Type: "beneficiary", ID: id, Name: name}, id
+}
+
+// promiseAndCommitmentBatch validates args and builds the atomic
+// promise+commitment batch for a commiter with public key pub.
+func promiseAndCommitmentBatch(pub ed25519.PublicKey, args CreatePromiseArgs) (AtomicBatch, string, error) {
+	if args.Text == "" {
+		return AtomicBatch{}, "", errors.New("--text is required")
+	}
+	if args.BeneficiaryID == "" {
+		return AtomicBatch{}, "", errors.New("--beneficiary-id is required")
+	}
+	promiseDue, err := parseWhen(args.DueISO)
+	if err != nil {
+		return AtomicBatch{}, "", fmt.Errorf("promise --due: %w", err)
+	}
+	commitDue, err := parseWhen(args.CommitmentDueISO)
+	if err != nil {
+		return AtomicBatch{}, "", fmt.Errorf("commitment --commitment-due: %w", err)
+	}
+
+	commiterID := "commiter:" + base64.StdEncoding.EncodeToString(pub)
+	promiseID := mustUUID("promise")
+	commitmentID := mustUUID("commitment")
+
+	var parentPtr *string
+	if args.ParentPromiseID != "" {
+		p := args.ParentPromiseID
+		parentPtr = &p
+	}
+
+	promise := &Promise{
+		Type:            "promise",
+		ID:              promiseID,
+		Text:            args.Text,
+		Due:             promiseDue,
+		BeneficiaryID:   args.BeneficiaryID,
+		ParentPromiseID: parentPtr,
+	}
+	commitment := &Commitment{
+		Type:       "commitment",
+		ID:         commitmentID,
+		PromiseID:  promiseID,
+		CommiterID: commiterID,
+		Due:        commitDue,
+	}
+
+	return AtomicBatch{Items: []BatchItem{
+		{Type: "promise", Body: promise},
+		{Type: "commitment", Body: commitment},
+	}}, promiseID, nil
+}
+
+// RegisterCommiter signs and broadcasts a commiter-registration tx for the
+// client's key pair.
+func (c *Client) RegisterCommiter(ctx context.Context, name string) error {
+	pub, err := c.publicKey()
+	if err != nil {
+		return err
+	}
+	body, _ := commiterBody(pub, name)
+	sigB64, raw, err := c.sign(body)
+	if err != nil {
+		return err
+	}
+	tx := SignedTx{Body: json.RawMessage(raw), Signature: sigB64}
+	txBytes, _ := json.Marshal(tx)
+	return c.broadcastWithRetry(ctx, base64.StdEncoding.EncodeToString(txBytes))
+}
+
+// CreateBeneficiary signs and broadcasts a new beneficiary, returning its ID.
+func (c *Client) CreateBeneficiary(ctx context.Context, name string) (string, error) {
+	body, id := beneficiaryBody(name)
+	sigB64, raw, err := c.sign(body)
+	if err != nil {
+		return "", err
+	}
+	tx := SignedTx{Body: json.RawMessage(raw), Signature: sigB64}
+	txBytes, _ := json.Marshal(tx)
+	if err := c.broadcastWithRetry(ctx, base64.StdEncoding.EncodeToString(txBytes)); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// CreatePromiseAndCommit signs and broadcasts a promise together with the
+// commiter's commitment to it, as a single atomic tx.
+func (c *Client) CreatePromiseAndCommit(ctx context.Context, args CreatePromiseArgs) error {
+	pub, err := c.publicKey()
+	if err != nil {
+		return err
+	}
+	batch, _, err := promiseAndCommitmentBatch(pub, args)
+	if err != nil {
+		return err
+	}
+	return c.BroadcastBatch(ctx, batch)
+}
+
+// QueryResult is the decoded outcome of an abci_query call.
+type QueryResult struct {
+	Code   int
+	Height string
+	Value  []byte
+}
+
+// Query issues a raw abci_query against path, with optional data (sent as
+// base64) and height.
+func (c *Client) Query(ctx context.Context, path, data, height string) (*QueryResult, error) {
+	var dataB64 string
+	if data != "" {
+		dataB64 = base64.StdEncoding.EncodeToString([]byte(data))
+	}
+	q, err := c.abciQuery(ctx, path, dataB64, height)
+	if err != nil {
+		return nil, err
+	}
+	var value []byte
+	if q.Result.Response.Value != "" {
+		value, err = base64.StdEncoding.DecodeString(q.Result.Response.Value)
+		if err != nil {
+			return nil, fmt.Errorf("decode value: %w", err)
+		}
+	}
+	return &QueryResult{
+		Code:   q.Result.Response.Code,
+		Height: q.Result.Response.Height,
+		Value:  value,
+	}, nil
+}
+
+// entityAlias maps a user-facing entity name to its ABCI list path.
+func entityAlias(entity string) (string, error) {
+	switch entity {
+	case "promise", "commitment", "commiter", "beneficiary":
+		return "/list/" + entity, nil
+	default:
+		return "", fmt.Errorf("unknown entity %q", entity)
+	}
+}
+
+// List fetches and decodes every item the node returns for the given
+// entity alias (promise|commitment|commiter|beneficiary) as []T.
+func List[T any](ctx context.Context, c *Client, entity string) ([]T, error) {
+	path, err := entityAlias(entity)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.Query(ctx, path, "", "")
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Value) == 0 {
+		return nil, nil
+	}
+	var items []T
+	if err := json.Unmarshal(res.Value, &items); err != nil {
+		return nil, fmt.Errorf("decode %s list: %w", entity, err)
+	}
+	return items, nil
+}
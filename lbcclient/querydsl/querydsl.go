@@ -0,0 +1,36 @@
+// Package querydsl encodes list-query filters into the bytes the ABCI
+// app's /list/<entity> query handler expects in the abci_query `data`
+// field. Both the client and the server decode the same JSON shape, so
+// this package is meant to be the single source of truth for that wire
+// format — import it from the server side too rather than hand-rolling a
+// second encoder that can drift out of sync.
+package querydsl
+
+import "encoding/json"
+
+// Filter narrows a /list/<entity> query: by beneficiary, by commiter, by a
+// due-date range, and/or paginated via Limit/Cursor.
+type Filter struct {
+	BeneficiaryID  string `json:"beneficiary_id,omitempty"`
+	CommiterPubKey string `json:"commiter_pubkey,omitempty"`
+	DueAfter       int64  `json:"due_after,omitempty"`
+	DueBefore      int64  `json:"due_before,omitempty"`
+	Limit          int    `json:"limit,omitempty"`
+	Cursor         string `json:"cursor,omitempty"`
+}
+
+// Encode marshals f into the bytes sent as the abci_query `data` field.
+func Encode(f Filter) ([]byte, error) {
+	return json.Marshal(f)
+}
+
+// Decode parses an encoded Filter back out of the abci_query `data` field.
+// Provided so a server-side handler can share this package too.
+func Decode(data []byte) (Filter, error) {
+	var f Filter
+	if len(data) == 0 {
+		return f, nil
+	}
+	err := json.Unmarshal(data, &f)
+	return f, err
+}
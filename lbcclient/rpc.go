@@ -0,0 +1,186 @@
+package lbcclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ErrRPC is the sentinel every *RPCError wraps, so callers can test for it
+// with errors.Is without caring about the specific code/message.
+var ErrRPC = errors.New("rpc error")
+
+// RPCError is returned whenever the node's JSON-RPC layer reports a
+// structured error. CheckTx/DeliverTx rejections are reported as plain
+// errors, not as *RPCError.
+type RPCError struct {
+	Code    int
+	Message string
+	Data    string
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("RPC error: %d %s (%s)", e.Code, e.Message, e.Data)
+}
+
+func (e *RPCError) Unwrap() error { return ErrRPC }
+
+// txResult is the check_tx/deliver_tx shape returned by broadcast_tx_commit.
+type txResult struct {
+	Code uint32 `json:"code"`
+	Log  string `json:"log"`
+}
+
+// rpcResp covers both the broadcast_tx_commit result shape (check_tx +
+// deliver_tx) and the broadcast_tx_sync/async shape (top-level code/log).
+type rpcResp struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      any    `json:"id"`
+	Error   *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Data    string `json:"data"`
+	} `json:"error"`
+	Result *struct {
+		Code      uint32    `json:"code"`
+		Log       string    `json:"log"`
+		CheckTx   *txResult `json:"check_tx"`
+		DeliverTx *txResult `json:"deliver_tx"`
+	} `json:"result"`
+}
+
+// errTxInCache marks a mempool rejection that means "already broadcast" —
+// a transient error the retry layer retries with backoff like any other,
+// and returns to the caller if it's still the result once retries are
+// exhausted (the caller's earlier broadcast is what succeeded, not this
+// one, and broadcast_tx_sync/async give no other way to confirm that).
+var errTxInCache = errors.New("tx already exists in cache")
+
+func parseRPCResult(data []byte) error {
+	var r rpcResp
+	_ = json.Unmarshal(data, &r)
+	if r.Error != nil {
+		return &RPCError{Code: r.Error.Code, Message: r.Error.Message, Data: r.Error.Data}
+	}
+	if r.Result == nil {
+		return fmt.Errorf("empty result")
+	}
+	if r.Result.CheckTx != nil && r.Result.CheckTx.Code != 0 {
+		if strings.Contains(r.Result.CheckTx.Log, "already exists in cache") {
+			return errTxInCache
+		}
+		return fmt.Errorf("CheckTx failed: %s", r.Result.CheckTx.Log)
+	}
+	if r.Result.DeliverTx != nil && r.Result.DeliverTx.Code != 0 {
+		return fmt.Errorf("DeliverTx failed: %s", r.Result.DeliverTx.Log)
+	}
+	// broadcast_tx_sync/async: only check_tx/the top-level code is known yet.
+	if r.Result.CheckTx == nil && r.Result.DeliverTx == nil && r.Result.Code != 0 {
+		if strings.Contains(r.Result.Log, "already exists in cache") {
+			return errTxInCache
+		}
+		return fmt.Errorf("broadcast rejected: %s", r.Result.Log)
+	}
+	return nil
+}
+
+// httpStatusError records a 5xx HTTP response from the RPC endpoint itself
+// (as opposed to a JSON-RPC-level error), so the retry layer can treat it as
+// transient. Other non-2xx responses are not wrapped in it; postRPC parses
+// their body like any other result.
+type httpStatusError struct {
+	status int
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("RPC endpoint returned HTTP %d", e.status)
+}
+
+func (c *Client) postRPC(ctx context.Context, txB64 string) error {
+	final := map[string]any{
+		"jsonrpc": "2.0",
+		"id":      uuid.NewString(),
+		"method":  c.broadcastMode.rpcMethod(),
+		"params": map[string]string{
+			"tx": txB64,
+		},
+	}
+	finalBytes, _ := json.Marshal(final)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.rpcURL, bytes.NewReader(finalBytes))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode >= 500 {
+		return &httpStatusError{status: resp.StatusCode}
+	}
+	return parseRPCResult(data)
+}
+
+type abciQueryResp struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      any    `json:"id"`
+	Result  struct {
+		Response struct {
+			Code      int    `json:"code"`
+			Log       string `json:"log"`
+			Info      string `json:"info"`
+			Index     string `json:"index"`
+			Key       string `json:"key"`
+			Value     string `json:"value"` // base64
+			ProofOps  any    `json:"proofOps"`
+			Height    string `json:"height"`
+			Codespace string `json:"codespace"`
+		} `json:"response"`
+	} `json:"result"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Data    string `json:"data"`
+	} `json:"error"`
+}
+
+func (c *Client) abciQuery(ctx context.Context, path, dataB64, height string) (*abciQueryResp, error) {
+	v := url.Values{}
+	// Tendermint любит path в кавычках, как в твоём примере
+	v.Set("path", fmt.Sprintf("%q", path))
+	if dataB64 != "" {
+		v.Set("data", dataB64)
+	}
+	if height != "" {
+		v.Set("height", height)
+	}
+	u := strings.TrimRight(c.rpcURL, "/") + "/abci_query?" + v.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, _ := io.ReadAll(resp.Body)
+	var q abciQueryResp
+	if err := json.Unmarshal(b, &q); err != nil {
+		return nil, fmt.Errorf("decode json: %w", err)
+	}
+	if q.Error != nil {
+		return nil, &RPCError{Code: q.Error.Code, Message: q.Error.Message, Data: q.Error.Data}
+	}
+	return &q, nil
+}
@@ -0,0 +1,68 @@
+package lbcclient
+
+// ===== Tx bodies per ER =====
+
+type Commiter struct {
+	Type           string `json:"type"` // "commiter"
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	CommiterPubKey string `json:"commiter_pubkey"`
+}
+
+type Beneficiary struct {
+	Type string `json:"type"` // "beneficiary"
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Promise struct {
+	Type            string  `json:"type"` // "promise"
+	ID              string  `json:"id"`
+	Text            string  `json:"text"`
+	Due             int64   `json:"due"`
+	BeneficiaryID   string  `json:"beneficiary_id"`
+	ParentPromiseID *string `json:"parent_promise_id"`
+}
+
+type Commitment struct {
+	Type       string `json:"type"` // "commitment"
+	ID         string `json:"id"`
+	PromiseID  string `json:"promise_id"`
+	CommiterID string `json:"commiter_id"`
+	Due        int64  `json:"due"`
+}
+
+type SignedTx struct {
+	Body      any    `json:"body"`
+	Signature string `json:"signature"`
+}
+
+// BatchItem is one heterogeneous entry in an AtomicBatch: Type discriminates
+// how Body should be decoded (commiter|beneficiary|promise|commitment).
+type BatchItem struct {
+	Type string `json:"type"`
+	Body any    `json:"body"`
+}
+
+// AtomicBatch is an ordered list of tx bodies meant to be signed and
+// broadcast as a single unit, e.g. registering a commiter and creating a
+// beneficiary and a promise+commitment in one tx.
+type AtomicBatch struct {
+	Items []BatchItem `json:"items"`
+}
+
+// SignedBatch is an AtomicBatch plus the single signature covering it.
+type SignedBatch struct {
+	Batch     AtomicBatch `json:"batch"`
+	Signature string      `json:"signature"`
+}
+
+// CreatePromiseArgs bundles the inputs needed to atomically create a promise
+// and its accompanying commitment.
+type CreatePromiseArgs struct {
+	Text             string
+	DueISO           string
+	BeneficiaryID    string
+	ParentPromiseID  string
+	CommitmentDueISO string
+}
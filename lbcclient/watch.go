@@ -0,0 +1,296 @@
+package lbcclient
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+type subscribeRequest struct {
+	JSONRPC string         `json:"jsonrpc"`
+	ID      any            `json:"id"`
+	Method  string         `json:"method"`
+	Params  map[string]any `json:"params"`
+}
+
+type subscribeEvent struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      any    `json:"id"`
+	Error   *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Data    string `json:"data"`
+	} `json:"error"`
+	Result struct {
+		Query string `json:"query"`
+		Data  struct {
+			Type  string `json:"type"`
+			Value struct {
+				TxResult struct {
+					Height string `json:"height"`
+					Tx     string `json:"tx"` // base64
+				} `json:"TxResult"`
+			} `json:"value"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// DecodedTx is the subset of a signed/composite tx the watcher knows how to
+// decode and pretty-print.
+type DecodedTx struct {
+	Commiter    *Commiter    `json:"commiter,omitempty"`
+	Beneficiary *Beneficiary `json:"beneficiary,omitempty"`
+	Promise     *Promise     `json:"promise,omitempty"`
+	Commitment  *Commitment  `json:"commitment,omitempty"`
+}
+
+// errPermanentSubscribe wraps a subscribe-time RPCError (e.g. a malformed
+// --query) to tell Watch that reconnecting won't fix it — the error should
+// propagate to the caller instead of driving the reconnect loop forever.
+type errPermanentSubscribe struct {
+	err error
+}
+
+func (e *errPermanentSubscribe) Error() string { return e.err.Error() }
+func (e *errPermanentSubscribe) Unwrap() error { return e.err }
+
+// HasType reports whether t (commiter|beneficiary|promise|commitment) is
+// present in this decoded tx.
+func (d *DecodedTx) HasType(t string) bool {
+	switch t {
+	case "commiter":
+		return d.Commiter != nil
+	case "beneficiary":
+		return d.Beneficiary != nil
+	case "promise":
+		return d.Promise != nil
+	case "commitment":
+		return d.Commitment != nil
+	}
+	return false
+}
+
+// decodeTxBytes understands both the plain SignedTx envelope (commiter/beneficiary)
+// and the SignedBatch envelope (e.g. promise+commitment).
+func decodeTxBytes(raw []byte) (*DecodedTx, error) {
+	var batch struct {
+		Batch struct {
+			Items []json.RawMessage `json:"items"`
+		} `json:"batch"`
+	}
+	if err := json.Unmarshal(raw, &batch); err == nil && len(batch.Batch.Items) > 0 {
+		return decodeBatchItems(batch.Batch.Items)
+	}
+
+	var single struct {
+		Body struct {
+			Type string `json:"type"`
+		} `json:"body"`
+	}
+	if err := json.Unmarshal(raw, &single); err != nil {
+		return nil, fmt.Errorf("decode tx envelope: %w", err)
+	}
+
+	switch single.Body.Type {
+	case "commiter":
+		var tx struct {
+			Body Commiter `json:"body"`
+		}
+		if err := json.Unmarshal(raw, &tx); err != nil {
+			return nil, err
+		}
+		return &DecodedTx{Commiter: &tx.Body}, nil
+	case "beneficiary":
+		var tx struct {
+			Body Beneficiary `json:"body"`
+		}
+		if err := json.Unmarshal(raw, &tx); err != nil {
+			return nil, err
+		}
+		return &DecodedTx{Beneficiary: &tx.Body}, nil
+	default:
+		return nil, fmt.Errorf("unrecognized tx body type %q", single.Body.Type)
+	}
+}
+
+// decodeBatchItems decodes an AtomicBatch's items into a single DecodedTx,
+// e.g. the promise+commitment pair CreatePromiseAndCommit broadcasts.
+func decodeBatchItems(items []json.RawMessage) (*DecodedTx, error) {
+	var tx DecodedTx
+	for _, raw := range items {
+		var item struct {
+			Type string          `json:"type"`
+			Body json.RawMessage `json:"body"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, fmt.Errorf("decode batch item: %w", err)
+		}
+		switch item.Type {
+		case "commiter":
+			var body Commiter
+			if err := json.Unmarshal(item.Body, &body); err != nil {
+				return nil, err
+			}
+			tx.Commiter = &body
+		case "beneficiary":
+			var body Beneficiary
+			if err := json.Unmarshal(item.Body, &body); err != nil {
+				return nil, err
+			}
+			tx.Beneficiary = &body
+		case "promise":
+			var body Promise
+			if err := json.Unmarshal(item.Body, &body); err != nil {
+				return nil, err
+			}
+			tx.Promise = &body
+		case "commitment":
+			var body Commitment
+			if err := json.Unmarshal(item.Body, &body); err != nil {
+				return nil, err
+			}
+			tx.Commitment = &body
+		default:
+			return nil, fmt.Errorf("unrecognized batch item type %q", item.Type)
+		}
+	}
+	return &tx, nil
+}
+
+func rpcURLToWS(rpcURL string) (string, error) {
+	u, err := url.Parse(rpcURL)
+	if err != nil {
+		return "", fmt.Errorf("parse rpc url: %w", err)
+	}
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	case "ws", "wss":
+		// already a websocket URL
+	default:
+		return "", fmt.Errorf("unsupported RPC scheme %q", u.Scheme)
+	}
+	if !strings.HasSuffix(u.Path, "/websocket") {
+		u.Path = strings.TrimRight(u.Path, "/") + "/websocket"
+	}
+	return u.String(), nil
+}
+
+// Watch opens a Tendermint websocket connection, issues a JSON-RPC
+// "subscribe" call for query, and invokes onTx for every decoded tx event
+// until ctx is cancelled. It reconnects with exponential backoff (capped at
+// 30s, with jitter) whenever the socket drops.
+func (c *Client) Watch(ctx context.Context, query string, onTx func(height string, tx *DecodedTx)) error {
+	wsURL, err := rpcURLToWS(c.rpcURL)
+	if err != nil {
+		return err
+	}
+
+	backoff := time.Second
+	const maxBackoff = 30 * time.Second
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		resetBackoff := func() { backoff = time.Second }
+		if err := c.watchOnce(ctx, wsURL, query, onTx, resetBackoff); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			var perm *errPermanentSubscribe
+			if errors.As(err, &perm) {
+				return perm.err
+			}
+			c.logger.Printf("websocket dropped: %v (reconnecting in %s)", err, backoff)
+			select {
+			case <-time.After(backoff + time.Duration(rand.Int63n(int64(backoff)/2+1))):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+			continue
+		}
+		return nil
+	}
+}
+
+func (c *Client) watchOnce(ctx context.Context, wsURL, query string, onTx func(height string, tx *DecodedTx), resetBackoff func()) error {
+	dialer := websocket.Dialer{HandshakeTimeout: 10 * time.Second}
+	conn, _, err := dialer.DialContext(ctx, wsURL, nil)
+	if err != nil {
+		return fmt.Errorf("dial: %w", err)
+	}
+	defer conn.Close()
+
+	req := subscribeRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "subscribe",
+		Params:  map[string]any{"query": query},
+	}
+	if err := conn.WriteJSON(req); err != nil {
+		return fmt.Errorf("send subscribe: %w", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		_ = conn.SetReadDeadline(time.Now())
+		close(done)
+	}()
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			select {
+			case <-done:
+				return ctx.Err()
+			default:
+				return err
+			}
+		}
+
+		// A frame made it over the wire, so the connection is healthy;
+		// forgive whatever backoff the previous reconnect accumulated.
+		resetBackoff()
+
+		var ev subscribeEvent
+		if err := json.Unmarshal(data, &ev); err != nil {
+			c.logger.Printf("cannot decode event: %v", err)
+			continue
+		}
+		if ev.Error != nil {
+			return &errPermanentSubscribe{&RPCError{Code: ev.Error.Code, Message: ev.Error.Message, Data: ev.Error.Data}}
+		}
+		txB64 := ev.Result.Data.Value.TxResult.Tx
+		if txB64 == "" {
+			// NewBlock or other non-tx event: nothing to decode.
+			continue
+		}
+		raw, err := base64.StdEncoding.DecodeString(txB64)
+		if err != nil {
+			c.logger.Printf("cannot base64-decode tx: %v", err)
+			continue
+		}
+		tx, err := decodeTxBytes(raw)
+		if err != nil {
+			c.logger.Printf("cannot decode tx body: %v", err)
+			continue
+		}
+		onTx(ev.Result.Data.Value.TxResult.Height, tx)
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/gregorybednov/lbc_client_go/lbcclient"
+	"github.com/spf13/pflag"
+)
+
+// ===== CLI: watch =====
+
+func watchMain(args []string) {
+	fs := pflag.NewFlagSet("watch", pflag.ExitOnError)
+	var rpc, query, filterType, format string
+	var timeout time.Duration
+
+	fs.StringVar(&rpc, "rpc", "http://localhost:26657", "Tendermint RPC URL")
+	fs.StringVar(&query, "query", "tm.event='Tx' AND tx.height>0", "Tendermint subscribe query")
+	fs.StringVar(&filterType, "filter-type", "", "only print tx of this type: commiter|beneficiary|promise|commitment")
+	fs.StringVar(&format, "format", "pretty", "output format: json|pretty")
+	fs.DurationVar(&timeout, "timeout", 0, "stop watching after this long (0 = run until interrupted)")
+
+	_ = fs.Parse(args)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	if timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+		defer cancelTimeout()
+	}
+
+	client := lbcclient.NewClient(lbcclient.WithRPCURL(rpc))
+
+	fmt.Fprintf(os.Stderr, "👀 watching %s (query=%q)\n", rpc, query)
+
+	err := client.Watch(ctx, query, func(height string, tx *lbcclient.DecodedTx) {
+		if filterType != "" && !tx.HasType(filterType) {
+			return
+		}
+		switch format {
+		case "json":
+			printRawJSON(map[string]any{"height": height, "tx": tx})
+		default:
+			printTxPretty(height, tx)
+		}
+	})
+	if err != nil && err != context.Canceled && err != context.DeadlineExceeded {
+		fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// printTxPretty prints a line for every body decoded tx carries. These are
+// not mutually exclusive: an AtomicBatch like the promise+commitment pair
+// CreatePromiseAndCommit broadcasts populates more than one field on tx.
+func printTxPretty(height string, tx *lbcclient.DecodedTx) {
+	if tx.Commiter != nil {
+		fmt.Printf("[%s] commiter   id=%s name=%s\n", height, tx.Commiter.ID, tx.Commiter.Name)
+	}
+	if tx.Beneficiary != nil {
+		fmt.Printf("[%s] beneficiary id=%s name=%s\n", height, tx.Beneficiary.ID, tx.Beneficiary.Name)
+	}
+	if tx.Promise != nil {
+		fmt.Printf("[%s] promise    id=%s beneficiary=%s due=%d\n", height, tx.Promise.ID, tx.Promise.BeneficiaryID, tx.Promise.Due)
+	}
+	if tx.Commitment != nil {
+		fmt.Printf("[%s] commitment id=%s promise=%s commiter=%s due=%d\n", height, tx.Commitment.ID, tx.Commitment.PromiseID, tx.Commitment.CommiterID, tx.Commitment.Due)
+	}
+}